@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+)
+
+const asyncOperationPollInterval = 2 * time.Second
+
+// AwaitAsyncOperation polls a Temporal Cloud async operation until it reaches a terminal state,
+// returning an error if it failed, was cancelled, or ctx is done first.
+func AwaitAsyncOperation(ctx context.Context, c *Client, op *cloudservicev1.AsyncOperation) error {
+	if op == nil {
+		return nil
+	}
+
+	for {
+		resp, err := c.CloudService().GetAsyncOperation(ctx, &cloudservicev1.GetAsyncOperationRequest{
+			AsyncOperationId: op.GetId(),
+		})
+		if err != nil {
+			return fmt.Errorf("getting async operation %s: %w", op.GetId(), err)
+		}
+
+		state := resp.GetAsyncOperation().GetState().String()
+		switch {
+		case strings.Contains(state, "FULFILLED"):
+			return nil
+		case strings.Contains(state, "FAILED"), strings.Contains(state, "CANCELLED"):
+			return fmt.Errorf("async operation %s did not complete successfully (state=%s)", op.GetId(), state)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(asyncOperationPollInterval):
+		}
+	}
+}
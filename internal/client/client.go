@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Client wraps the generated Temporal Cloud CloudService RPC client together with the
+// provider-level policies (retry, apikey expiry) that resources and data sources consult but that
+// don't belong on the generated client itself.
+type Client struct {
+	conn         *grpc.ClientConn
+	cloudService cloudservicev1.CloudServiceClient
+
+	retry            RetryConfig
+	maxLifetime      time.Duration
+	expiryWarnBefore time.Duration
+}
+
+// Config holds the settings needed to connect to Temporal Cloud, sourced from the provider's
+// schema during Configure.
+type Config struct {
+	// Endpoint is the host:port of the Temporal Cloud API, e.g. "saas-api.tmprl.cloud:443".
+	Endpoint string
+	// APIKey authenticates requests to Temporal Cloud.
+	APIKey string
+	// AllowInsecure disables TLS on the connection. Only intended for use against a local or
+	// test Temporal Cloud API.
+	AllowInsecure bool
+}
+
+// New dials the configured Temporal Cloud endpoint and returns a Client authenticated with
+// cfg.APIKey. The retry and expiry policies default to their zero values and are populated
+// separately via SetRetry/SetMaxLifetime/SetExpiryWarnBefore once the provider's `retry`,
+// `max_lifetime` and `warn_before` settings have been parsed.
+func New(cfg Config) (*Client, error) {
+	transportCreds := credentials.NewTLS(&tls.Config{})
+	if cfg.AllowInsecure {
+		transportCreds = insecure.NewCredentials()
+	}
+
+	conn, err := grpc.NewClient(cfg.Endpoint,
+		grpc.WithTransportCredentials(transportCreds),
+		grpc.WithPerRPCCredentials(apiKeyCredentials{apiKey: cfg.APIKey, allowInsecure: cfg.AllowInsecure}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("dialing Temporal Cloud endpoint %q: %w", cfg.Endpoint, err)
+	}
+
+	return &Client{
+		conn:         conn,
+		cloudService: cloudservicev1.NewCloudServiceClient(conn),
+	}, nil
+}
+
+// CloudService returns the generated Temporal Cloud CloudService RPC client.
+func (c *Client) CloudService() cloudservicev1.CloudServiceClient {
+	return c.cloudService
+}
+
+// Close releases the underlying gRPC connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+type apiKeyCredentials struct {
+	apiKey        string
+	allowInsecure bool
+}
+
+func (a apiKeyCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + a.apiKey}, nil
+}
+
+func (a apiKeyCredentials) RequireTransportSecurity() bool {
+	return !a.allowInsecure
+}
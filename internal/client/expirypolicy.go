@@ -0,0 +1,37 @@
+package client
+
+import "time"
+
+// DefaultMaxLifetime is the maximum allowed lifetime for a temporalcloud_apikey's expiry_time
+// when the provider's `max_lifetime` setting is left unconfigured, matching Temporal Cloud's
+// default key lifetime policy.
+const DefaultMaxLifetime = 365 * 24 * time.Hour
+
+// MaxLifetime returns the maximum allowed distance between now and an apikey's expiry_time,
+// configured via the provider's `max_lifetime` setting, falling back to DefaultMaxLifetime.
+func (c *Client) MaxLifetime() time.Duration {
+	if c.maxLifetime <= 0 {
+		return DefaultMaxLifetime
+	}
+
+	return c.maxLifetime
+}
+
+// ExpiryWarnBefore returns how far ahead of an apikey's expiry_time `terraform plan` should start
+// warning that it's expiring soon, configured via the provider's `warn_before` setting. Zero
+// disables the warning.
+func (c *Client) ExpiryWarnBefore() time.Duration {
+	return c.expiryWarnBefore
+}
+
+// SetMaxLifetime sets the provider's `max_lifetime` policy on this client, populated during
+// Configure.
+func (c *Client) SetMaxLifetime(d time.Duration) {
+	c.maxLifetime = d
+}
+
+// SetExpiryWarnBefore sets the provider's `warn_before` policy on this client, populated during
+// Configure.
+func (c *Client) SetExpiryWarnBefore(d time.Duration) {
+	c.expiryWarnBefore = d
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+)
+
+// RetryConfig controls the exponential backoff used when retrying transient gRPC failures from
+// Temporal Cloud, such as during resource Create/Update/Delete or while awaiting an async
+// operation.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of times an operation is attempted, including the
+	// first attempt.
+	MaxAttempts int
+	// MaxInterval caps the backoff delay between attempts.
+	MaxInterval time.Duration
+}
+
+const (
+	defaultRetryMaxAttempts = 10
+	retryBaseInterval       = 500 * time.Millisecond
+	defaultRetryMaxInterval = 30 * time.Second
+)
+
+// DefaultRetryConfig returns the retry policy used when the provider's `retry` block is omitted.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: defaultRetryMaxAttempts,
+		MaxInterval: defaultRetryMaxInterval,
+	}
+}
+
+func isRetryableStatus(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.ResourceExhausted, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// RetryWithBackoff invokes fn until it succeeds, returns a non-retryable error, exhausts
+// cfg.MaxAttempts, or ctx is done. Retryable attempts back off exponentially with full jitter,
+// starting at 500ms and capped at cfg.MaxInterval; ctx (typically derived from the resource's
+// `timeouts` block) bounds the overall retry budget.
+func RetryWithBackoff(ctx context.Context, cfg RetryConfig, fn func() error) error {
+	if cfg.MaxAttempts <= 0 {
+		cfg = DefaultRetryConfig()
+	}
+	if cfg.MaxInterval <= 0 {
+		cfg.MaxInterval = defaultRetryMaxInterval
+	}
+
+	var err error
+	for attempt := 0; attempt < cfg.MaxAttempts; attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if !isRetryableStatus(err) {
+			return err
+		}
+		if attempt == cfg.MaxAttempts-1 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(fullJitterBackoff(attempt, cfg.MaxInterval)):
+		}
+	}
+
+	return err
+}
+
+// fullJitterBackoff returns a random duration in [0, min(base*2^attempt, maxInterval)).
+func fullJitterBackoff(attempt int, maxInterval time.Duration) time.Duration {
+	if maxInterval <= 0 {
+		maxInterval = defaultRetryMaxInterval
+	}
+
+	d := retryBaseInterval << attempt
+	if d <= 0 || d > maxInterval {
+		d = maxInterval
+	}
+
+	return time.Duration(rand.Int63n(int64(d)))
+}
+
+// Retry returns the retry policy configured for this client via the provider's `retry` block,
+// falling back to DefaultRetryConfig when none was set.
+func (c *Client) Retry() RetryConfig {
+	cfg := c.retry
+	if cfg.MaxAttempts == 0 {
+		cfg.MaxAttempts = defaultRetryMaxAttempts
+	}
+	if cfg.MaxInterval == 0 {
+		cfg.MaxInterval = defaultRetryMaxInterval
+	}
+
+	return cfg
+}
+
+// SetRetry sets the retry policy used by RetryWithBackoff for this client, populated from the
+// provider's `retry` schema block during Configure.
+func (c *Client) SetRetry(cfg RetryConfig) {
+	c.retry = cfg
+}
+
+// AwaitAsyncOperationWithRetry wraps AwaitAsyncOperation, retrying transient gRPC failures
+// (Unavailable, Aborted, ResourceExhausted, DeadlineExceeded) with exponential backoff and full
+// jitter.
+func AwaitAsyncOperationWithRetry(ctx context.Context, c *Client, op *cloudservicev1.AsyncOperation, cfg RetryConfig) error {
+	return RetryWithBackoff(ctx, cfg, func() error {
+		return AwaitAsyncOperation(ctx, c, op)
+	})
+}
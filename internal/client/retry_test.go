@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryWithBackoff_SucceedsAfterNFailures(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 5, MaxInterval: time.Millisecond}
+
+	attempts := 0
+	err := RetryWithBackoff(context.Background(), cfg, func() error {
+		attempts++
+		if attempts < 3 {
+			return status.Error(codes.Unavailable, "transient")
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("expected success, got error: %v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryWithBackoff_GivesUpAfterMaxAttempts(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 3, MaxInterval: time.Millisecond}
+
+	attempts := 0
+	err := RetryWithBackoff(context.Background(), cfg, func() error {
+		attempts++
+		return status.Error(codes.Unavailable, "transient")
+	})
+
+	if err == nil {
+		t.Fatal("expected an error after exhausting retries, got nil")
+	}
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected final error to be the last retryable error, got: %v", err)
+	}
+	if attempts != cfg.MaxAttempts {
+		t.Fatalf("expected exactly %d attempts, got %d", cfg.MaxAttempts, attempts)
+	}
+}
+
+func TestRetryWithBackoff_NonRetryableReturnsImmediately(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 5, MaxInterval: time.Millisecond}
+
+	attempts := 0
+	wantErr := status.Error(codes.InvalidArgument, "bad request")
+	err := RetryWithBackoff(context.Background(), cfg, func() error {
+		attempts++
+		return wantErr
+	})
+
+	if !errors.Is(err, wantErr) && err.Error() != wantErr.Error() {
+		t.Fatalf("expected non-retryable error to be returned unchanged, got: %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected exactly 1 attempt for a non-retryable error, got %d", attempts)
+	}
+}
+
+func TestRetryWithBackoff_ZeroMaxIntervalDoesNotPanic(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 3}
+
+	attempts := 0
+	err := RetryWithBackoff(context.Background(), cfg, func() error {
+		attempts++
+		if attempts < 2 {
+			return status.Error(codes.Unavailable, "transient")
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("expected success, got error: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryWithBackoff_ContextCancelledDuringBackoff(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 10, MaxInterval: time.Second}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	attempts := 0
+	err := RetryWithBackoff(ctx, cfg, func() error {
+		attempts++
+		if attempts == 1 {
+			cancel()
+		}
+		return status.Error(codes.Unavailable, "transient")
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+)
+
+type (
+	apiKeyDataSource struct {
+		client *client.Client
+	}
+
+	apiKeyDataSourceModel struct {
+		ID          types.String `tfsdk:"id"`
+		State       types.String `tfsdk:"state"`
+		OwnerType   types.String `tfsdk:"owner_type"`
+		OwnerID     types.String `tfsdk:"owner_id"`
+		DisplayName types.String `tfsdk:"display_name"`
+		Description types.String `tfsdk:"description"`
+		ExpiryTime  types.String `tfsdk:"expiry_time"`
+		Disabled    types.Bool   `tfsdk:"disabled"`
+	}
+)
+
+var (
+	_ datasource.DataSource              = (*apiKeyDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*apiKeyDataSource)(nil)
+)
+
+func NewApiKeyDataSource() datasource.DataSource {
+	return &apiKeyDataSource{}
+}
+
+func (d *apiKeyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *apiKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikey"
+}
+
+func (d *apiKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single Temporal Cloud API key by id. Useful for adopting an API key created out-of-band (CLI, UI, another workspace) into Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the API key.",
+				Required:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "The current state of the API key.",
+				Computed:    true,
+			},
+			"owner_type": schema.StringAttribute{
+				Description: "The type of the owner of the API key.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The ID of the owner of the API key.",
+				Computed:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display name for the API key.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description for the API key.",
+				Computed:    true,
+			},
+			"expiry_time": schema.StringAttribute{
+				Description: "The expiry time for the API key in ISO 8601 format.",
+				Computed:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Whether the API key is disabled.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *apiKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config apiKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := d.client.CloudService().GetApiKey(ctx, &cloudservicev1.GetApiKeyRequest{
+		KeyId: config.ID.ValueString(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			resp.Diagnostics.AddError("API Key Not Found", fmt.Sprintf("An API key with id %q does not exist.", config.ID.ValueString()))
+			return
+		}
+
+		resp.Diagnostics.AddError("Failed to get API key", err.Error())
+		return
+	}
+
+	model, err := apiKeyDataSourceModelFromSpec(apiKey.ApiKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to convert apikey spec", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func apiKeyDataSourceModelFromSpec(apikey *identityv1.ApiKey) (*apiKeyDataSourceModel, error) {
+	stateStr, err := enums.FromResourceState(apikey.GetState())
+	if err != nil {
+		return nil, err
+	}
+	ownerType, err := enums.FromOwnerType(apikey.GetSpec().GetOwnerType())
+	if err != nil {
+		return nil, err
+	}
+
+	model := &apiKeyDataSourceModel{
+		ID:          types.StringValue(apikey.GetId()),
+		State:       types.StringValue(stateStr),
+		OwnerID:     types.StringValue(apikey.GetSpec().GetOwnerId()),
+		OwnerType:   types.StringValue(ownerType),
+		DisplayName: types.StringValue(apikey.GetSpec().GetDisplayName()),
+		Description: types.StringValue(apikey.GetSpec().GetDescription()),
+		ExpiryTime:  types.StringValue(apikey.GetSpec().GetExpiryTime().AsTime().Format(time.RFC3339)),
+		Disabled:    types.BoolValue(apikey.GetSpec().GetDisabled()),
+	}
+
+	return model, nil
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const expiryTimeRawConfigPrivateKey = "expiry_time_raw_config"
+
+// parseExpiryTime accepts either an absolute RFC3339 timestamp or a relative duration of the form
+// "+90d" / "+2160h" (anchored to time.Now()). Units supported for the relative form are any unit
+// time.ParseDuration understands, plus "d" for days, since ParseDuration has no day unit.
+func parseExpiryTime(raw string) (time.Time, error) {
+	if !strings.HasPrefix(raw, "+") {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("expiry_time must be RFC3339 or a relative duration like \"+90d\": %w", err)
+		}
+		return t, nil
+	}
+
+	rest := raw[1:]
+	if strings.HasSuffix(rest, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(rest, "d"))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid relative expiry_time %q: %w", raw, err)
+		}
+		return time.Now().Add(time.Duration(days) * 24 * time.Hour), nil
+	}
+
+	dur, err := time.ParseDuration(rest)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid relative expiry_time %q: %w", raw, err)
+	}
+	return time.Now().Add(dur), nil
+}
+
+// expiryTimeFormatValidator rejects an expiry_time that's neither RFC3339 nor a relative duration,
+// independent of any provider-level policy.
+type expiryTimeFormatValidator struct{}
+
+func (v expiryTimeFormatValidator) Description(_ context.Context) string {
+	return "expiry_time must be an RFC3339 timestamp or a relative duration like \"+90d\" or \"+2160h\"."
+}
+
+func (v expiryTimeFormatValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v expiryTimeFormatValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseExpiryTime(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid expiry_time", err.Error())
+	}
+}
+
+// expiryTimeNormalizer resolves a relative expiry_time ("+90d") to an absolute RFC3339 timestamp
+// at plan time. It stashes the raw relative config in private state so that re-plans where the
+// user's relative expression hasn't changed reuse the previously resolved value from state,
+// instead of re-resolving against time.Now() and producing a spurious diff every plan.
+type expiryTimeNormalizer struct{}
+
+func (m expiryTimeNormalizer) Description(_ context.Context) string {
+	return "Normalizes a relative expiry_time to an absolute RFC3339 timestamp at plan time."
+}
+
+func (m expiryTimeNormalizer) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m expiryTimeNormalizer) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	raw := req.ConfigValue.ValueString()
+	if !strings.HasPrefix(raw, "+") {
+		// Already absolute; nothing to normalize.
+		return
+	}
+
+	var storedRaw string
+	if bytes, diags := req.Private.GetKey(ctx, expiryTimeRawConfigPrivateKey); !diags.HasError() && bytes != nil {
+		storedRaw = strings.Trim(string(bytes), `"`)
+	}
+
+	if storedRaw == raw && !req.StateValue.IsNull() {
+		resp.PlanValue = req.StateValue
+		return
+	}
+
+	resolved, err := parseExpiryTime(raw)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid expiry_time", err.Error())
+		return
+	}
+
+	resp.PlanValue = types.StringValue(resolved.Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, expiryTimeRawConfigPrivateKey, []byte(`"`+raw+`"`))...)
+}
+
+// validateExpiryTimePolicy enforces the provider-level expiry policy: expiry_time must not be in
+// the past or farther out than client.MaxLifetime, and a warning is emitted when it falls within
+// client.ExpiryWarnBefore of now so `terraform plan` surfaces soon-to-expire keys in CI.
+//
+// This runs from ModifyPlan against the already-resolved expiry_time (absolute, post
+// expiryTimeNormalizer) rather than from ValidateConfig against the raw config: for a relative
+// expiry_time like "+90d", re-parsing the raw config on every plan would anchor the check to
+// "right now" instead of the timestamp that was actually resolved and stored, silently defeating
+// max_lifetime and warn_before once the key exists.
+func (r *apiKeyResource) validateExpiryTimePolicy(expiry time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.client == nil {
+		return diags
+	}
+
+	now := time.Now()
+	if expiry.Before(now) {
+		diags.AddAttributeError(path.Root("expiry_time"), "expiry_time Is In The Past",
+			fmt.Sprintf("expiry_time %s is in the past.", expiry.Format(time.RFC3339)))
+		return diags
+	}
+
+	if maxLifetime := r.client.MaxLifetime(); maxLifetime > 0 && expiry.After(now.Add(maxLifetime)) {
+		diags.AddAttributeError(path.Root("expiry_time"), "expiry_time Exceeds max_lifetime",
+			fmt.Sprintf("expiry_time %s is more than %s from now, which exceeds the provider's configured max_lifetime.", expiry.Format(time.RFC3339), maxLifetime))
+		return diags
+	}
+
+	if warnBefore := r.client.ExpiryWarnBefore(); warnBefore > 0 && expiry.Before(now.Add(warnBefore)) {
+		diags.AddAttributeWarning(path.Root("expiry_time"), "API Key Expires Soon",
+			fmt.Sprintf("expiry_time %s is within %s of now.", expiry.Format(time.RFC3339), warnBefore))
+	}
+
+	return diags
+}
@@ -12,10 +12,12 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
@@ -30,22 +32,37 @@ type (
 	}
 
 	apiKeyResourceModel struct {
-		ID          types.String   `tfsdk:"id"`
-		State       types.String   `tfsdk:"state"`
-		OwnerType   types.String   `tfsdk:"owner_type"`
-		OwnerID     types.String   `tfsdk:"owner_id"`
-		DisplayName types.String   `tfsdk:"display_name"`
-		Token       types.String   `tfsdk:"token"`
-		Description types.String   `tfsdk:"description"`
-		ExpiryTime  types.String   `tfsdk:"expiry_time"` // ISO 8601 format
-		Disabled    types.Bool     `tfsdk:"disabled"`
-		Timeouts    timeouts.Value `tfsdk:"timeouts"`
+		ID                types.String         `tfsdk:"id"`
+		State             types.String         `tfsdk:"state"`
+		OwnerType         types.String         `tfsdk:"owner_type"`
+		OwnerID           types.String         `tfsdk:"owner_id"`
+		DisplayName       types.String         `tfsdk:"display_name"`
+		Token             types.String         `tfsdk:"token"`
+		Description       types.String         `tfsdk:"description"`
+		ExpiryTime        types.String         `tfsdk:"expiry_time"` // ISO 8601 format
+		Disabled          types.Bool           `tfsdk:"disabled"`
+		Rotation          *apiKeyRotationModel `tfsdk:"rotation"`
+		PreviousID        types.String         `tfsdk:"previous_id"`
+		PreviousToken     types.String         `tfsdk:"previous_token"`
+		PreviousRotatedAt types.String         `tfsdk:"previous_rotated_at"`
+		TokenSink         *tokenSinkModel      `tfsdk:"token_sink"`
+		TokenRef          types.String         `tfsdk:"token_ref"`
+		Timeouts          timeouts.Value       `tfsdk:"timeouts"`
+	}
+
+	apiKeyRotationModel struct {
+		RotateBefore   types.String `tfsdk:"rotate_before"`
+		RotationPeriod types.String `tfsdk:"rotation_period"`
+		GracePeriod    types.String `tfsdk:"grace_period"`
 	}
 )
 
 var (
-	_ resource.Resource              = (*apiKeyResource)(nil)
-	_ resource.ResourceWithConfigure = (*apiKeyResource)(nil)
+	_ resource.Resource                   = (*apiKeyResource)(nil)
+	_ resource.ResourceWithConfigure      = (*apiKeyResource)(nil)
+	_ resource.ResourceWithImportState    = (*apiKeyResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*apiKeyResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*apiKeyResource)(nil)
 )
 
 func NewApiKeyResource() resource.Resource {
@@ -74,6 +91,17 @@ func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_apikey"
 }
 
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddWarning(
+		"Imported Token Will Be Null",
+		"Temporal Cloud only returns an API key's token at creation time, so it cannot be recovered by import. "+
+			"The `token` attribute will remain null in state after this import completes. Add `lifecycle { ignore_changes = [token] }` "+
+			"to this resource to prevent Terraform from trying to reconcile it on subsequent applies.",
+	)
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
 func (r *apiKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Provisions a Temporal Cloud API key.",
@@ -123,9 +151,13 @@ func (r *apiKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, r
 				Optional:    true,
 			},
 			"expiry_time": schema.StringAttribute{
-				Description: "The expiry time for the API key in ISO 8601 format.",
+				Description: "The expiry time for the API key, either an RFC3339 timestamp or a relative duration such as `\"+90d\"` / `\"+2160h\"`, normalized to RFC3339 at plan time.",
 				Required:    true,
+				Validators: []validator.String{
+					expiryTimeFormatValidator{},
+				},
 				PlanModifiers: []planmodifier.String{
+					expiryTimeNormalizer{},
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -135,12 +167,87 @@ func (r *apiKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"previous_id": schema.StringAttribute{
+				Description: "The id of the previous API key, populated after `rotation` replaces the active key and retained until `rotation.grace_period` elapses.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_token": schema.StringAttribute{
+				Description: "The token of the previous API key, populated after `rotation` replaces the active key and retained until `rotation.grace_period` elapses.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_rotated_at": schema.StringAttribute{
+				Description: "Internal bookkeeping timestamp recording when the previous key was retired from active use. Used to determine when `rotation.grace_period` has elapsed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_ref": schema.StringAttribute{
+				Description: "An opaque reference to where `token` was written when `token_sink` is set (e.g. a secret ARN or Vault path). Null when no sink is configured.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
 				Delete: true,
 			}),
+			"token_sink": schema.SingleNestedBlock{
+				Description: "Writes the API key's token to an external secret store instead of leaving it resident in Terraform state. When set, `token` is stored as null and the sink's reference is exposed as `token_ref`.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The kind of sink to write the token to. One of `aws_secrets_manager`, `gcp_secret_manager`, `vault_kv_v2`, `kubernetes_secret`, `file`.",
+						Required:    true,
+					},
+					"secret_name": schema.StringAttribute{
+						Description: "Required for `aws_secrets_manager`, `gcp_secret_manager` (full resource name), and `kubernetes_secret`.",
+						Optional:    true,
+					},
+					"kms_key_id": schema.StringAttribute{
+						Description: "Optional KMS key used to encrypt the secret. Only applies to `aws_secrets_manager`.",
+						Optional:    true,
+					},
+					"path": schema.StringAttribute{
+						Description: "Required for `vault_kv_v2` (the KV v2 path) and `file` (the destination file path).",
+						Optional:    true,
+					},
+					"namespace": schema.StringAttribute{
+						Description: "Required for `kubernetes_secret`. Optional Vault namespace for `vault_kv_v2`.",
+						Optional:    true,
+					},
+					"key": schema.StringAttribute{
+						Description: "The secret data key to write the token under. Only applies to `kubernetes_secret`; defaults to `token`.",
+						Optional:    true,
+					},
+				},
+			},
+			"rotation": schema.SingleNestedBlock{
+				Description: "Configures automatic rotation of this API key before it expires, keeping the previous key alive for a grace period so dependents can cut over without downtime.",
+				Attributes: map[string]schema.Attribute{
+					"rotate_before": schema.StringAttribute{
+						Description: "How long before `expiry_time` to rotate the key, expressed as a Go duration (e.g. `\"72h\"`). Required to enable rotation.",
+						Optional:    true,
+					},
+					"rotation_period": schema.StringAttribute{
+						Description: "The lifetime to assign the newly rotated key's `expiry_time`, expressed as a Go duration (e.g. `\"2160h\"`). Defaults to `rotateDefaultRotationPeriod` (90 days) when unset.",
+						Optional:    true,
+					},
+					"grace_period": schema.StringAttribute{
+						Description: "How long to keep the previous key active after rotation before it is deleted, expressed as a Go duration (e.g. `\"24h\"`). Defaults to `rotateDefaultGracePeriod` (1 hour) when unset.",
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -187,23 +294,27 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError(err.Error(), "")
 		return
 	}
-	svcResp, err := r.client.CloudService().CreateApiKey(ctx, &cloudservicev1.CreateApiKeyRequest{
-		Spec: &identityv1.ApiKeySpec{
-			OwnerId:     plan.OwnerID.ValueString(),
-			OwnerType:   ownerType,
-			DisplayName: plan.DisplayName.ValueString(),
-			Description: description,
-			ExpiryTime:  expiryTimestamp,
-			Disabled:    disabled,
-		},
-		AsyncOperationId: uuid.New().String(),
+	asyncOperationID := uuid.New().String()
+	var svcResp *cloudservicev1.CreateApiKeyResponse
+	err = client.RetryWithBackoff(ctx, r.client.Retry(), func() error {
+		svcResp, err = r.client.CloudService().CreateApiKey(ctx, &cloudservicev1.CreateApiKeyRequest{
+			Spec: &identityv1.ApiKeySpec{
+				OwnerId:     plan.OwnerID.ValueString(),
+				OwnerType:   ownerType,
+				DisplayName: plan.DisplayName.ValueString(),
+				Description: description,
+				ExpiryTime:  expiryTimestamp,
+				Disabled:    disabled,
+			},
+			AsyncOperationId: asyncOperationID,
+		})
+		return err
 	})
-
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create API key", err.Error())
 		return
 	}
-	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.AsyncOperation); err != nil {
+	if err := client.AwaitAsyncOperationWithRetry(ctx, r.client, svcResp.AsyncOperation, r.client.Retry()); err != nil {
 		resp.Diagnostics.AddError("Failed to create API key", err.Error())
 		return
 	}
@@ -221,7 +332,15 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("Failed to convert apikey spec", err.Error())
 		return
 	}
-	plan.Token = types.StringValue(svcResp.Token)
+	if err, warning := r.recordToken(ctx, &plan, plan.ID.ValueString(), svcResp.Token); err != nil {
+		resp.Diagnostics.AddError("Failed to write API key token to token_sink", err.Error())
+		return
+	} else if warning != "" {
+		resp.Diagnostics.AddWarning("Token Stored In State", warning)
+	}
+	plan.PreviousID = types.StringNull()
+	plan.PreviousToken = types.StringNull()
+	plan.PreviousRotatedAt = types.StringNull()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -255,6 +374,12 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		resp.Diagnostics.AddError("Failed to convert apikey spec", err.Error())
 		return
 	}
+
+	if err := r.reconcileRetiredKey(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("Failed to retire previous API key", err.Error())
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -265,6 +390,24 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	var state apiKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ID.IsUnknown() {
+		// ModifyPlan determined rotation is due: create the replacement key and retire the
+		// current one to previous_id/previous_token instead of updating in place.
+		if err := r.rotateApiKey(ctx, &plan, state); err != nil {
+			resp.Diagnostics.AddError("Failed to rotate API key", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
 	apiKey, err := r.client.CloudService().GetApiKey(ctx, &cloudservicev1.GetApiKeyRequest{
 		KeyId: plan.ID.ValueString(),
 	})
@@ -299,25 +442,30 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		resp.Diagnostics.AddError(err.Error(), "")
 		return
 	}
-	svcResp, err := r.client.CloudService().UpdateApiKey(ctx, &cloudservicev1.UpdateApiKeyRequest{
-		KeyId: plan.ID.ValueString(),
-		Spec: &identityv1.ApiKeySpec{
-			OwnerId:     plan.OwnerID.ValueString(),
-			OwnerType:   ownerType,
-			DisplayName: plan.DisplayName.ValueString(),
-			Description: description,
-			ExpiryTime:  expiryTimestamp,
-			Disabled:    disabled,
-		},
-		ResourceVersion:  apiKey.GetApiKey().GetResourceVersion(),
-		AsyncOperationId: uuid.New().String(),
+	asyncOperationID := uuid.New().String()
+	var svcResp *cloudservicev1.UpdateApiKeyResponse
+	err = client.RetryWithBackoff(ctx, r.client.Retry(), func() error {
+		svcResp, err = r.client.CloudService().UpdateApiKey(ctx, &cloudservicev1.UpdateApiKeyRequest{
+			KeyId: plan.ID.ValueString(),
+			Spec: &identityv1.ApiKeySpec{
+				OwnerId:     plan.OwnerID.ValueString(),
+				OwnerType:   ownerType,
+				DisplayName: plan.DisplayName.ValueString(),
+				Description: description,
+				ExpiryTime:  expiryTimestamp,
+				Disabled:    disabled,
+			},
+			ResourceVersion:  apiKey.GetApiKey().GetResourceVersion(),
+			AsyncOperationId: asyncOperationID,
+		})
+		return err
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update API key", err.Error())
 		return
 	}
 
-	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+	if err := client.AwaitAsyncOperationWithRetry(ctx, r.client, svcResp.GetAsyncOperation(), r.client.Retry()); err != nil {
 		resp.Diagnostics.AddError("Failed to update API key", err.Error())
 		return
 	}
@@ -370,10 +518,15 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
 	defer cancel()
 
-	svcResp, err := r.client.CloudService().DeleteApiKey(ctx, &cloudservicev1.DeleteApiKeyRequest{
-		KeyId:            state.ID.ValueString(),
-		ResourceVersion:  apiKey.GetApiKey().GetResourceVersion(),
-		AsyncOperationId: uuid.New().String(),
+	asyncOperationID := uuid.New().String()
+	var svcResp *cloudservicev1.DeleteApiKeyResponse
+	err = client.RetryWithBackoff(ctx, r.client.Retry(), func() error {
+		svcResp, err = r.client.CloudService().DeleteApiKey(ctx, &cloudservicev1.DeleteApiKeyRequest{
+			KeyId:            state.ID.ValueString(),
+			ResourceVersion:  apiKey.GetApiKey().GetResourceVersion(),
+			AsyncOperationId: asyncOperationID,
+		})
+		return err
 	})
 	if err != nil {
 		switch status.Code(err) {
@@ -389,8 +542,44 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.AsyncOperation); err != nil {
+	if err := client.AwaitAsyncOperationWithRetry(ctx, r.client, svcResp.AsyncOperation, r.client.Retry()); err != nil {
 		resp.Diagnostics.AddError("Failed to delete API key", err.Error())
+		return
+	}
+
+	if !state.PreviousID.IsNull() && state.PreviousID.ValueString() != "" {
+		var previousApiKey *cloudservicev1.GetApiKeyResponse
+		err = client.RetryWithBackoff(ctx, r.client.Retry(), func() error {
+			previousApiKey, err = r.client.CloudService().GetApiKey(ctx, &cloudservicev1.GetApiKeyRequest{
+				KeyId: state.PreviousID.ValueString(),
+			})
+			return err
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return
+			}
+			resp.Diagnostics.AddError("Failed to get previous API key status", err.Error())
+			return
+		}
+
+		previousAsyncOperationID := uuid.New().String()
+		var previousDeleteResp *cloudservicev1.DeleteApiKeyResponse
+		err = client.RetryWithBackoff(ctx, r.client.Retry(), func() error {
+			previousDeleteResp, err = r.client.CloudService().DeleteApiKey(ctx, &cloudservicev1.DeleteApiKeyRequest{
+				KeyId:            state.PreviousID.ValueString(),
+				ResourceVersion:  previousApiKey.GetApiKey().GetResourceVersion(),
+				AsyncOperationId: previousAsyncOperationID,
+			})
+			return err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to delete previous API key", err.Error())
+			return
+		}
+		if err := client.AwaitAsyncOperationWithRetry(ctx, r.client, previousDeleteResp.AsyncOperation, r.client.Retry()); err != nil {
+			resp.Diagnostics.AddError("Failed to delete previous API key", err.Error())
+		}
 	}
 }
 
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	rotateDefaultRotationPeriod = 90 * 24 * time.Hour
+	rotateDefaultGracePeriod    = time.Hour
+)
+
+// ModifyPlan computes the next rotation of the API key when `rotation` is configured: once
+// now + rotate_before reaches the currently stored expiry_time, it plans a new expiry_time and
+// marks id/token/previous_id/previous_token unknown so Update can perform the rotation.
+func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Deletion; nothing to validate or rotate.
+		return
+	}
+
+	var plan apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ExpiryTime.IsNull() && !plan.ExpiryTime.IsUnknown() {
+		if expiry, err := time.Parse(time.RFC3339, plan.ExpiryTime.ValueString()); err == nil {
+			resp.Diagnostics.Append(r.validateExpiryTimePolicy(expiry)...)
+		}
+	}
+
+	if req.State.Raw.IsNull() || plan.Rotation == nil || plan.Rotation.RotateBefore.IsNull() {
+		// Creation, or rotation not configured; nothing left to do.
+		return
+	}
+
+	var state apiKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotateBefore, err := time.ParseDuration(plan.Rotation.RotateBefore.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rotation").AtName("rotate_before"), "Invalid rotate_before", err.Error())
+		return
+	}
+
+	currentExpiry, err := time.Parse(time.RFC3339, state.ExpiryTime.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expiry_time"), "Invalid expiry_time", err.Error())
+		return
+	}
+
+	if time.Now().Add(rotateBefore).Before(currentExpiry) {
+		// Not time to rotate yet.
+		return
+	}
+
+	rotationPeriod := rotateDefaultRotationPeriod
+	if !plan.Rotation.RotationPeriod.IsNull() {
+		rotationPeriod, err = time.ParseDuration(plan.Rotation.RotationPeriod.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rotation").AtName("rotation_period"), "Invalid rotation_period", err.Error())
+			return
+		}
+	}
+
+	newExpiry := time.Now().Add(rotationPeriod)
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expiry_time"), types.StringValue(newExpiry.Format(time.RFC3339)))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("token"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_token"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_rotated_at"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("token_ref"), types.StringUnknown())...)
+}
+
+// rotateApiKey creates a replacement key ahead of expiry and retires the previously active key
+// into previous_id/previous_token rather than deleting it outright, so dependents get a grace
+// window to cut over. The caller is expected to have already confirmed rotation is due.
+func (r *apiKeyResource) rotateApiKey(ctx context.Context, plan *apiKeyResourceModel, state apiKeyResourceModel) error {
+	expiryTime, err := time.Parse(time.RFC3339, plan.ExpiryTime.ValueString())
+	if err != nil {
+		return err
+	}
+
+	ownerType, err := enums.ToOwnerType(plan.OwnerType.ValueString())
+	if err != nil {
+		return err
+	}
+
+	asyncOperationID := uuid.New().String()
+	var svcResp *cloudservicev1.CreateApiKeyResponse
+	err = client.RetryWithBackoff(ctx, r.client.Retry(), func() error {
+		svcResp, err = r.client.CloudService().CreateApiKey(ctx, &cloudservicev1.CreateApiKeyRequest{
+			Spec: &identityv1.ApiKeySpec{
+				OwnerId:     plan.OwnerID.ValueString(),
+				OwnerType:   ownerType,
+				DisplayName: plan.DisplayName.ValueString(),
+				Description: plan.Description.ValueString(),
+				ExpiryTime:  timestamppb.New(expiryTime),
+				Disabled:    plan.Disabled.ValueBool(),
+			},
+			AsyncOperationId: asyncOperationID,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if err := client.AwaitAsyncOperationWithRetry(ctx, r.client, svcResp.AsyncOperation, r.client.Retry()); err != nil {
+		return err
+	}
+
+	newApiKey, err := r.client.CloudService().GetApiKey(ctx, &cloudservicev1.GetApiKeyRequest{
+		KeyId: svcResp.GetKeyId(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := updateApiKeyModelFromSpec(plan, newApiKey.ApiKey); err != nil {
+		return err
+	}
+	if err, _ := r.recordToken(ctx, plan, plan.ID.ValueString(), svcResp.Token); err != nil {
+		return err
+	}
+
+	plan.PreviousID = state.ID
+	plan.PreviousToken = state.Token
+	plan.PreviousRotatedAt = types.StringValue(time.Now().Format(time.RFC3339))
+
+	return nil
+}
+
+// reconcileRetiredKey deletes the previous key once rotation.grace_period has elapsed since it
+// was retired, clearing previous_id/previous_token/previous_rotated_at from state. It is called
+// from Read, which reports a returned error as an error diagnostic and fails.
+func (r *apiKeyResource) reconcileRetiredKey(ctx context.Context, model *apiKeyResourceModel) error {
+	if model.PreviousID.IsNull() || model.PreviousID.ValueString() == "" {
+		return nil
+	}
+
+	gracePeriod := rotateDefaultGracePeriod
+	if model.Rotation != nil && !model.Rotation.GracePeriod.IsNull() {
+		d, err := time.ParseDuration(model.Rotation.GracePeriod.ValueString())
+		if err != nil {
+			return err
+		}
+		gracePeriod = d
+	}
+
+	rotatedAt, err := time.Parse(time.RFC3339, model.PreviousRotatedAt.ValueString())
+	if err != nil || time.Now().Before(rotatedAt.Add(gracePeriod)) {
+		return nil
+	}
+
+	var previousApiKey *cloudservicev1.GetApiKeyResponse
+	err = client.RetryWithBackoff(ctx, r.client.Retry(), func() error {
+		previousApiKey, err = r.client.CloudService().GetApiKey(ctx, &cloudservicev1.GetApiKeyRequest{
+			KeyId: model.PreviousID.ValueString(),
+		})
+		return err
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			model.PreviousID = types.StringNull()
+			model.PreviousToken = types.StringNull()
+			model.PreviousRotatedAt = types.StringNull()
+			return nil
+		}
+		return err
+	}
+
+	asyncOperationID := uuid.New().String()
+	var svcResp *cloudservicev1.DeleteApiKeyResponse
+	err = client.RetryWithBackoff(ctx, r.client.Retry(), func() error {
+		svcResp, err = r.client.CloudService().DeleteApiKey(ctx, &cloudservicev1.DeleteApiKeyRequest{
+			KeyId:            model.PreviousID.ValueString(),
+			ResourceVersion:  previousApiKey.GetApiKey().GetResourceVersion(),
+			AsyncOperationId: asyncOperationID,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if err := client.AwaitAsyncOperationWithRetry(ctx, r.client, svcResp.AsyncOperation, r.client.Retry()); err != nil {
+		return err
+	}
+
+	model.PreviousID = types.StringNull()
+	model.PreviousToken = types.StringNull()
+	model.PreviousRotatedAt = types.StringNull()
+	return nil
+}
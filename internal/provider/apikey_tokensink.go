@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/tokensink"
+)
+
+type tokenSinkModel struct {
+	Type       types.String `tfsdk:"type"`
+	SecretName types.String `tfsdk:"secret_name"`
+	KMSKeyID   types.String `tfsdk:"kms_key_id"`
+	Path       types.String `tfsdk:"path"`
+	Namespace  types.String `tfsdk:"namespace"`
+	Key        types.String `tfsdk:"key"`
+}
+
+func (m *tokenSinkModel) toConfig() tokensink.Config {
+	return tokensink.Config{
+		Type:       m.Type.ValueString(),
+		SecretName: m.SecretName.ValueString(),
+		KMSKeyID:   m.KMSKeyID.ValueString(),
+		Path:       m.Path.ValueString(),
+		Namespace:  m.Namespace.ValueString(),
+		Key:        m.Key.ValueString(),
+	}
+}
+
+// ValidateConfig rejects a token_sink block that's missing the sub-attributes its type requires.
+// The provider's expiry_time policy (max_lifetime/warn_before) is enforced from ModifyPlan
+// instead, since it needs the resolved expiry_time rather than raw, possibly-relative config.
+func (r *apiKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config apiKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.TokenSink == nil || config.TokenSink.Type.IsUnknown() || config.TokenSink.Type.IsNull() {
+		return
+	}
+
+	if err := tokensink.Validate(config.TokenSink.toConfig()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("token_sink"), "Invalid token_sink Configuration", err.Error())
+	}
+}
+
+// recordToken stores the newly issued token according to plan.TokenSink: written to the
+// configured external sink with only the opaque reference kept in state, or, absent a sink, left
+// in state directly (with a warning nudging users toward a sink).
+func (r *apiKeyResource) recordToken(ctx context.Context, plan *apiKeyResourceModel, id, token string) (diagErr error, warning string) {
+	if plan.TokenSink == nil {
+		plan.Token = types.StringValue(token)
+		plan.TokenRef = types.StringNull()
+		return nil, "No `token_sink` is configured, so the API key token will be stored directly in Terraform state. Configure `token_sink` to keep it out of state."
+	}
+
+	sink, err := tokensink.New(plan.TokenSink.toConfig())
+	if err != nil {
+		return err, ""
+	}
+
+	ref, err := sink.Write(ctx, id, token)
+	if err != nil {
+		return err, ""
+	}
+
+	plan.Token = types.StringNull()
+	plan.TokenRef = types.StringValue(ref)
+	return nil, ""
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+)
+
+type (
+	apiKeysDataSource struct {
+		client *client.Client
+	}
+
+	apiKeysDataSourceModel struct {
+		OwnerType types.String            `tfsdk:"owner_type"`
+		OwnerID   types.String            `tfsdk:"owner_id"`
+		State     types.String            `tfsdk:"state"`
+		ApiKeys   []apiKeyDataSourceModel `tfsdk:"api_keys"`
+	}
+)
+
+var (
+	_ datasource.DataSource              = (*apiKeysDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*apiKeysDataSource)(nil)
+)
+
+func NewApiKeysDataSource() datasource.DataSource {
+	return &apiKeysDataSource{}
+}
+
+func (d *apiKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *apiKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikeys"
+}
+
+func (d *apiKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up Temporal Cloud API keys, optionally filtered by owner_type, owner_id, and/or state.",
+		Attributes: map[string]schema.Attribute{
+			"owner_type": schema.StringAttribute{
+				Description: "Filter API keys by the type of their owner.",
+				Optional:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "Filter API keys by the id of their owner.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Filter API keys by their current state.",
+				Optional:    true,
+			},
+			"api_keys": schema.ListNestedAttribute{
+				Description: "The list of API keys matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the API key.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The current state of the API key.",
+							Computed:    true,
+						},
+						"owner_type": schema.StringAttribute{
+							Description: "The type of the owner of the API key.",
+							Computed:    true,
+						},
+						"owner_id": schema.StringAttribute{
+							Description: "The ID of the owner of the API key.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The display name for the API key.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description for the API key.",
+							Computed:    true,
+						},
+						"expiry_time": schema.StringAttribute{
+							Description: "The expiry time for the API key in ISO 8601 format.",
+							Computed:    true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "Whether the API key is disabled.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *apiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config apiKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ownerType int32
+	if !config.OwnerType.IsNull() {
+		t, err := enums.ToOwnerType(config.OwnerType.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(err.Error(), "")
+			return
+		}
+		ownerType = int32(t)
+	}
+
+	apiKeys := make([]apiKeyDataSourceModel, 0)
+	pageToken := ""
+	for {
+		svcResp, err := d.client.CloudService().GetApiKeys(ctx, &cloudservicev1.GetApiKeysRequest{
+			OwnerId:   config.OwnerID.ValueString(),
+			OwnerType: ownerType,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list API keys", err.Error())
+			return
+		}
+
+		for _, apikey := range svcResp.GetApiKeys() {
+			model, err := apiKeyDataSourceModelFromSpec(apikey)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to convert apikey spec", err.Error())
+				return
+			}
+
+			if !config.State.IsNull() && model.State.ValueString() != config.State.ValueString() {
+				continue
+			}
+
+			apiKeys = append(apiKeys, *model)
+		}
+
+		pageToken = svcResp.GetNextPageToken()
+		if pageToken == "" {
+			break
+		}
+	}
+
+	config.ApiKeys = apiKeys
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
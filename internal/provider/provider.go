@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+)
+
+type retryModel struct {
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	MaxInterval types.String `tfsdk:"max_interval"`
+}
+
+const defaultEndpoint = "saas-api.tmprl.cloud:443"
+
+type (
+	temporalCloudProvider struct {
+		version string
+	}
+
+	temporalCloudProviderModel struct {
+		Endpoint      types.String `tfsdk:"endpoint"`
+		ApiKey        types.String `tfsdk:"api_key"`
+		AllowInsecure types.Bool   `tfsdk:"allow_insecure"`
+		Retry         *retryModel  `tfsdk:"retry"`
+		MaxLifetime   types.String `tfsdk:"max_lifetime"`
+		WarnBefore    types.String `tfsdk:"warn_before"`
+	}
+)
+
+var (
+	_ provider.Provider = (*temporalCloudProvider)(nil)
+)
+
+// New returns a provider.Provider factory for the given build version, for use with
+// providerserver.NewProtocol6(New(version)()) in main.go.
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &temporalCloudProvider{version: version}
+	}
+}
+
+func (p *temporalCloudProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "temporalcloud"
+	resp.Version = p.version
+}
+
+func (p *temporalCloudProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages Temporal Cloud resources.",
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Description: "The host:port of the Temporal Cloud API. Defaults to the value of the TEMPORAL_CLOUD_ENDPOINT " +
+					"environment variable, or \"" + defaultEndpoint + "\" if unset.",
+				Optional: true,
+			},
+			"api_key": schema.StringAttribute{
+				Description: "The API key used to authenticate with Temporal Cloud. Defaults to the value of the " +
+					"TEMPORAL_CLOUD_API_KEY environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"allow_insecure": schema.BoolAttribute{
+				Description: "Disables TLS on the connection to Temporal Cloud. Only intended for use against a local " +
+					"or test Temporal Cloud API.",
+				Optional: true,
+			},
+			"max_lifetime": schema.StringAttribute{
+				Description: "The maximum allowed distance between now and a temporalcloud_apikey's expiry_time, as a Go " +
+					"duration string (e.g. \"8760h\"). Defaults to client.DefaultMaxLifetime when unset.",
+				Optional: true,
+			},
+			"warn_before": schema.StringAttribute{
+				Description: "How far ahead of a temporalcloud_apikey's expiry_time `terraform plan` should start warning " +
+					"that it's expiring soon, as a Go duration string (e.g. \"720h\"). Unset disables the warning.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.SingleNestedBlock{
+				Description: "Controls the exponential backoff used when retrying transient gRPC failures from Temporal " +
+					"Cloud. Defaults to 10 attempts capped at a 30s interval when omitted.",
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						Description: "The maximum number of times an operation is attempted, including the first attempt.",
+						Optional:    true,
+					},
+					"max_interval": schema.StringAttribute{
+						Description: "The maximum backoff delay between attempts, as a Go duration string (e.g. \"30s\").",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (p *temporalCloudProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config temporalCloudProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := os.Getenv("TEMPORAL_CLOUD_ENDPOINT")
+	if !config.Endpoint.IsNull() {
+		endpoint = config.Endpoint.ValueString()
+	}
+	if endpoint == "" {
+		endpoint = defaultEndpoint
+	}
+
+	apiKey := os.Getenv("TEMPORAL_CLOUD_API_KEY")
+	if !config.ApiKey.IsNull() {
+		apiKey = config.ApiKey.ValueString()
+	}
+
+	cl, err := client.New(client.Config{
+		Endpoint:      endpoint,
+		APIKey:        apiKey,
+		AllowInsecure: config.AllowInsecure.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable To Create Temporal Cloud Client", err.Error())
+		return
+	}
+
+	if config.Retry != nil {
+		retryCfg := client.DefaultRetryConfig()
+		if !config.Retry.MaxAttempts.IsNull() {
+			retryCfg.MaxAttempts = int(config.Retry.MaxAttempts.ValueInt64())
+		}
+		if !config.Retry.MaxInterval.IsNull() {
+			maxInterval, err := time.ParseDuration(config.Retry.MaxInterval.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("retry").AtName("max_interval"), "Invalid max_interval", err.Error())
+				return
+			}
+			retryCfg.MaxInterval = maxInterval
+		}
+		cl.SetRetry(retryCfg)
+	}
+
+	if !config.MaxLifetime.IsNull() {
+		maxLifetime, err := time.ParseDuration(config.MaxLifetime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("max_lifetime"), "Invalid max_lifetime", err.Error())
+			return
+		}
+		cl.SetMaxLifetime(maxLifetime)
+	}
+
+	if !config.WarnBefore.IsNull() {
+		warnBefore, err := time.ParseDuration(config.WarnBefore.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("warn_before"), "Invalid warn_before", err.Error())
+			return
+		}
+		cl.SetExpiryWarnBefore(warnBefore)
+	}
+
+	resp.ResourceData = cl
+	resp.DataSourceData = cl
+}
+
+func (p *temporalCloudProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewApiKeyResource,
+	}
+}
+
+func (p *temporalCloudProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewApiKeyDataSource,
+		NewApiKeysDataSource,
+	}
+}
@@ -0,0 +1,55 @@
+package tokensink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+// awsSecretsManagerSink writes the token as a new version of an AWS Secrets Manager secret,
+// creating the secret if it doesn't already exist.
+type awsSecretsManagerSink struct {
+	secretName string
+	kmsKeyID   string
+}
+
+func (s *awsSecretsManagerSink) Write(ctx context.Context, _, token string) (string, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return "", fmt.Errorf("loading AWS config: %w", err)
+	}
+
+	client := secretsmanager.NewFromConfig(cfg)
+
+	putOut, err := client.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:     &s.secretName,
+		SecretString: &token,
+	})
+	if err == nil {
+		return *putOut.ARN, nil
+	}
+
+	var notFound *types.ResourceNotFoundException
+	if !errors.As(err, &notFound) {
+		return "", fmt.Errorf("writing token to Secrets Manager secret %q: %w", s.secretName, err)
+	}
+
+	createInput := &secretsmanager.CreateSecretInput{
+		Name:         &s.secretName,
+		SecretString: &token,
+	}
+	if s.kmsKeyID != "" {
+		createInput.KmsKeyId = &s.kmsKeyID
+	}
+
+	createOut, err := client.CreateSecret(ctx, createInput)
+	if err != nil {
+		return "", fmt.Errorf("creating Secrets Manager secret %q: %w", s.secretName, err)
+	}
+
+	return *createOut.ARN, nil
+}
@@ -0,0 +1,21 @@
+package tokensink
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// fileSink writes the token to a local file. Intended for local development and CI, not as a
+// durable production secret store.
+type fileSink struct {
+	path string
+}
+
+func (s *fileSink) Write(_ context.Context, _, token string) (string, error) {
+	if err := os.WriteFile(s.path, []byte(token), 0o600); err != nil {
+		return "", fmt.Errorf("writing token to file %q: %w", s.path, err)
+	}
+
+	return s.path, nil
+}
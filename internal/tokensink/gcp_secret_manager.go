@@ -0,0 +1,36 @@
+package tokensink
+
+import (
+	"context"
+	"fmt"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+)
+
+// gcpSecretManagerSink writes the token as a new version of a GCP Secret Manager secret.
+// secretName is the full resource name of the secret, e.g.
+// "projects/my-project/secrets/temporal-apikey".
+type gcpSecretManagerSink struct {
+	secretName string
+}
+
+func (s *gcpSecretManagerSink) Write(ctx context.Context, _, token string) (string, error) {
+	client, err := secretmanager.NewClient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("creating Secret Manager client: %w", err)
+	}
+	defer client.Close()
+
+	version, err := client.AddSecretVersion(ctx, &secretmanagerpb.AddSecretVersionRequest{
+		Parent: s.secretName,
+		Payload: &secretmanagerpb.SecretPayload{
+			Data: []byte(token),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("adding secret version to %q: %w", s.secretName, err)
+	}
+
+	return version.GetName(), nil
+}
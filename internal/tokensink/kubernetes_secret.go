@@ -0,0 +1,69 @@
+package tokensink
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const defaultKubernetesSecretKey = "token"
+
+// kubernetesSecretSink writes the token into a key of a Kubernetes Secret, creating the secret if
+// it doesn't already exist. Uses the in-cluster config, so it only applies when the provider runs
+// inside the target cluster.
+type kubernetesSecretSink struct {
+	name      string
+	namespace string
+	key       string
+}
+
+func (s *kubernetesSecretSink) Write(ctx context.Context, _, token string) (string, error) {
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return "", fmt.Errorf("loading in-cluster Kubernetes config: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return "", fmt.Errorf("creating Kubernetes client: %w", err)
+	}
+
+	key := s.key
+	if key == "" {
+		key = defaultKubernetesSecretKey
+	}
+
+	secrets := clientset.CoreV1().Secrets(s.namespace)
+	existing, err := secrets.Get(ctx, s.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		created, err := secrets.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: s.name, Namespace: s.namespace},
+			StringData: map[string]string{key: token},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return "", fmt.Errorf("creating Kubernetes secret %s/%s: %w", s.namespace, s.name, err)
+		}
+
+		return fmt.Sprintf("%s/%s#%s", created.Namespace, created.Name, key), nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("getting Kubernetes secret %s/%s: %w", s.namespace, s.name, err)
+	}
+
+	if existing.StringData == nil {
+		existing.StringData = map[string]string{}
+	}
+	existing.StringData[key] = token
+
+	updated, err := secrets.Update(ctx, existing, metav1.UpdateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("updating Kubernetes secret %s/%s: %w", s.namespace, s.name, err)
+	}
+
+	return fmt.Sprintf("%s/%s#%s", updated.Namespace, updated.Name, key), nil
+}
@@ -0,0 +1,76 @@
+// Package tokensink writes temporalcloud_apikey tokens to an external secret store instead of
+// leaving them resident in Terraform state.
+package tokensink
+
+import (
+	"context"
+	"fmt"
+)
+
+// TokenSink persists an API key's token somewhere outside of Terraform state and returns an
+// opaque reference that can safely be stored in state instead.
+type TokenSink interface {
+	Write(ctx context.Context, id, token string) (ref string, err error)
+}
+
+// Config carries the subset of the `token_sink` block's sub-attributes relevant to the selected
+// Type. Which fields are required depends on Type; see New.
+type Config struct {
+	Type       string
+	SecretName string
+	KMSKeyID   string
+	Path       string
+	Namespace  string
+	Key        string
+}
+
+const (
+	TypeAWSSecretsManager = "aws_secrets_manager"
+	TypeGCPSecretManager  = "gcp_secret_manager"
+	TypeVaultKVv2         = "vault_kv_v2"
+	TypeKubernetesSecret  = "kubernetes_secret"
+	TypeFile              = "file"
+)
+
+// New constructs the TokenSink implementation for cfg.Type. It returns an error if a sub-attribute
+// required by that type is missing; callers should prefer surfacing that error at plan time via
+// ValidateConfig rather than waiting for Create to fail.
+func New(cfg Config) (TokenSink, error) {
+	switch cfg.Type {
+	case TypeAWSSecretsManager:
+		if cfg.SecretName == "" {
+			return nil, fmt.Errorf("%s token sink requires secret_name", TypeAWSSecretsManager)
+		}
+		return &awsSecretsManagerSink{secretName: cfg.SecretName, kmsKeyID: cfg.KMSKeyID}, nil
+	case TypeGCPSecretManager:
+		if cfg.SecretName == "" {
+			return nil, fmt.Errorf("%s token sink requires secret_name (the full secret resource name)", TypeGCPSecretManager)
+		}
+		return &gcpSecretManagerSink{secretName: cfg.SecretName}, nil
+	case TypeVaultKVv2:
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("%s token sink requires path", TypeVaultKVv2)
+		}
+		return &vaultKVv2Sink{path: cfg.Path, namespace: cfg.Namespace}, nil
+	case TypeKubernetesSecret:
+		if cfg.SecretName == "" || cfg.Namespace == "" {
+			return nil, fmt.Errorf("%s token sink requires secret_name and namespace", TypeKubernetesSecret)
+		}
+		return &kubernetesSecretSink{name: cfg.SecretName, namespace: cfg.Namespace, key: cfg.Key}, nil
+	case TypeFile:
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("%s token sink requires path", TypeFile)
+		}
+		return &fileSink{path: cfg.Path}, nil
+	default:
+		return nil, fmt.Errorf("unsupported token_sink type %q", cfg.Type)
+	}
+}
+
+// Validate checks that cfg carries the sub-attributes its Type requires by calling New and
+// discarding the sink it returns; no connection to the secret store is made. Used by
+// ValidateConfig so misconfiguration surfaces at plan time.
+func Validate(cfg Config) error {
+	_, err := New(cfg)
+	return err
+}
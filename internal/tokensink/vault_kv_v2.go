@@ -0,0 +1,37 @@
+package tokensink
+
+import (
+	"context"
+	"fmt"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+// vaultKVv2Sink writes the token to a HashiCorp Vault KV v2 secrets engine path. path is the
+// logical path of the secret, e.g. "secret/data/temporal/apikey".
+type vaultKVv2Sink struct {
+	path      string
+	namespace string
+}
+
+func (s *vaultKVv2Sink) Write(ctx context.Context, id, token string) (string, error) {
+	client, err := vault.NewClient(vault.DefaultConfig())
+	if err != nil {
+		return "", fmt.Errorf("creating Vault client: %w", err)
+	}
+	if s.namespace != "" {
+		client.SetNamespace(s.namespace)
+	}
+
+	_, err = client.Logical().WriteWithContext(ctx, s.path, map[string]interface{}{
+		"data": map[string]interface{}{
+			"id":    id,
+			"token": token,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("writing token to Vault path %q: %w", s.path, err)
+	}
+
+	return s.path, nil
+}